@@ -0,0 +1,163 @@
+package valid
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Regular expressions used by the checks below.
+var (
+	regUUID     = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+	regULID     = regexp.MustCompile("^[0-7][0-9A-HJKMNP-TV-Z]{25}$")
+	regHexColor = regexp.MustCompile("^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$")
+	regE164     = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	regSemVer   = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+)
+
+// IsUUID creates a validator that consideres a string valid if it is a UUID in the canonical
+// 8-4-4-4-12 hyphenated hexadecimal form.
+func IsUUID(message string) StringValidator {
+	return RegexpCompiled(regUUID, message)
+}
+
+// IsULID creates a validator that consideres a string valid if it is a ULID: 26 characters
+// long, Crockford base32 encoded.
+func IsULID(message string) StringValidator {
+	return RegexpCompiled(regULID, message)
+}
+
+// IsBase64 creates a validator that consideres a string valid if it is valid standard base64,
+// with or without padding.
+func IsBase64(message string) StringValidator {
+	f := func(val string) error {
+		if _, err := base64.StdEncoding.DecodeString(val); err == nil {
+			return nil
+		}
+		if _, err := base64.RawStdEncoding.DecodeString(val); err == nil {
+			return nil
+		}
+		return errors.New(message)
+	}
+	return StringFunc(f)
+}
+
+// IsHexColor creates a validator that consideres a string valid if it is a 3 or 6 digit
+// hexadecimal color, with an optional leading "#".
+func IsHexColor(message string) StringValidator {
+	return RegexpCompiled(regHexColor, message)
+}
+
+// IsJSON creates a validator that consideres a string valid if it is syntactically valid JSON.
+func IsJSON(message string) StringValidator {
+	f := func(val string) error {
+		if !json.Valid([]byte(val)) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsCreditCard creates a validator that consideres a string valid if, once spaces and dashes
+// are stripped, it is made up entirely of digits, is at least 12 digits long and passes the
+// Luhn checksum used by major credit card networks.
+func IsCreditCard(message string) StringValidator {
+	f := func(val string) error {
+		digits := strings.Map(func(r rune) rune {
+			if r == ' ' || r == '-' {
+				return -1
+			}
+			return r
+		}, val)
+		if len(digits) < 12 || !luhnValid(digits) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// luhnValid reports whether digits, a string of decimal digits, passes the Luhn checksum.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// IsE164Phone creates a validator that consideres a string valid if it is an E.164 formatted
+// phone number: a "+" followed by 2 to 15 digits, the first of which is not zero.
+func IsE164Phone(message string) StringValidator {
+	return RegexpCompiled(regE164, message)
+}
+
+// IsISO3166Alpha2 creates a validator that consideres a string valid if it is an assigned
+// ISO 3166-1 alpha-2 country code, matched case-insensitively.
+func IsISO3166Alpha2(message string) StringValidator {
+	f := func(val string) error {
+		if !iso3166Alpha2[strings.ToUpper(val)] {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsSemVer creates a validator that consideres a string valid if it is a semantic version
+// according to semver.org: MAJOR.MINOR.PATCH with optional pre-release and build metadata.
+func IsSemVer(message string) StringValidator {
+	return RegexpCompiled(regSemVer, message)
+}
+
+// iso3166Alpha2 contains the currently assigned ISO 3166-1 alpha-2 country codes.
+var iso3166Alpha2 = map[string]bool{
+	"AD": true, "AE": true, "AF": true, "AG": true, "AI": true, "AL": true, "AM": true, "AO": true,
+	"AQ": true, "AR": true, "AS": true, "AT": true, "AU": true, "AW": true, "AX": true, "AZ": true,
+	"BA": true, "BB": true, "BD": true, "BE": true, "BF": true, "BG": true, "BH": true, "BI": true,
+	"BJ": true, "BL": true, "BM": true, "BN": true, "BO": true, "BQ": true, "BR": true, "BS": true,
+	"BT": true, "BV": true, "BW": true, "BY": true, "BZ": true, "CA": true, "CC": true, "CD": true,
+	"CF": true, "CG": true, "CH": true, "CI": true, "CK": true, "CL": true, "CM": true, "CN": true,
+	"CO": true, "CR": true, "CU": true, "CV": true, "CW": true, "CX": true, "CY": true, "CZ": true,
+	"DE": true, "DJ": true, "DK": true, "DM": true, "DO": true, "DZ": true, "EC": true, "EE": true,
+	"EG": true, "EH": true, "ER": true, "ES": true, "ET": true, "FI": true, "FJ": true, "FK": true,
+	"FM": true, "FO": true, "FR": true, "GA": true, "GB": true, "GD": true, "GE": true, "GF": true,
+	"GG": true, "GH": true, "GI": true, "GL": true, "GM": true, "GN": true, "GP": true, "GQ": true,
+	"GR": true, "GS": true, "GT": true, "GU": true, "GW": true, "GY": true, "HK": true, "HM": true,
+	"HN": true, "HR": true, "HT": true, "HU": true, "ID": true, "IE": true, "IL": true, "IM": true,
+	"IN": true, "IO": true, "IQ": true, "IR": true, "IS": true, "IT": true, "JE": true, "JM": true,
+	"JO": true, "JP": true, "KE": true, "KG": true, "KH": true, "KI": true, "KM": true, "KN": true,
+	"KP": true, "KR": true, "KW": true, "KY": true, "KZ": true, "LA": true, "LB": true, "LC": true,
+	"LI": true, "LK": true, "LR": true, "LS": true, "LT": true, "LU": true, "LV": true, "LY": true,
+	"MA": true, "MC": true, "MD": true, "ME": true, "MF": true, "MG": true, "MH": true, "MK": true,
+	"ML": true, "MM": true, "MN": true, "MO": true, "MP": true, "MQ": true, "MR": true, "MS": true,
+	"MT": true, "MU": true, "MV": true, "MW": true, "MX": true, "MY": true, "MZ": true, "NA": true,
+	"NC": true, "NE": true, "NF": true, "NG": true, "NI": true, "NL": true, "NO": true, "NP": true,
+	"NR": true, "NU": true, "NZ": true, "OM": true, "PA": true, "PE": true, "PF": true, "PG": true,
+	"PH": true, "PK": true, "PL": true, "PM": true, "PN": true, "PR": true, "PS": true, "PT": true,
+	"PW": true, "PY": true, "QA": true, "RE": true, "RO": true, "RS": true, "RU": true, "RW": true,
+	"SA": true, "SB": true, "SC": true, "SD": true, "SE": true, "SG": true, "SH": true, "SI": true,
+	"SJ": true, "SK": true, "SL": true, "SM": true, "SN": true, "SO": true, "SR": true, "SS": true,
+	"ST": true, "SV": true, "SX": true, "SY": true, "SZ": true, "TC": true, "TD": true, "TF": true,
+	"TG": true, "TH": true, "TJ": true, "TK": true, "TL": true, "TM": true, "TN": true, "TO": true,
+	"TR": true, "TT": true, "TV": true, "TW": true, "TZ": true, "UA": true, "UG": true, "UM": true,
+	"US": true, "UY": true, "UZ": true, "VA": true, "VC": true, "VE": true, "VG": true, "VI": true,
+	"VN": true, "VU": true, "WF": true, "WS": true, "YE": true, "YT": true, "ZA": true, "ZM": true,
+	"ZW": true,
+}
@@ -0,0 +1,64 @@
+package valid
+
+import (
+	"testing"
+)
+
+var checksTests = []stringTest{
+	// IsUUID
+	{"123e4567-e89b-12d3-a456-426614174000", IsUUID("a"), true, "valid UUID."},
+	{"not-a-uuid", IsUUID("a"), false, "invalid UUID."},
+
+	// IsULID
+	{"01ARZ3NDEKTSV4RRFFQ69G5FAV", IsULID("a"), true, "valid ULID."},
+	{"not-a-ulid", IsULID("a"), false, "invalid ULID."},
+
+	// IsBase64
+	{"aGVsbG8=", IsBase64("a"), true, "valid base64."},
+	{"not base64!!", IsBase64("a"), false, "invalid base64."},
+
+	// IsHexColor
+	{"#fff", IsHexColor("a"), true, "valid 3-digit hex color."},
+	{"#ffffff", IsHexColor("a"), true, "valid 6-digit hex color."},
+	{"ffffff", IsHexColor("a"), true, "valid 6-digit hex color without #."},
+	{"#ffff", IsHexColor("a"), false, "invalid hex color length."},
+
+	// IsJSON
+	{`{"a":1}`, IsJSON("a"), true, "valid JSON object."},
+	{"not json", IsJSON("a"), false, "invalid JSON."},
+
+	// IsCreditCard
+	{"4111111111111111", IsCreditCard("a"), true, "valid Visa test number."},
+	{"4111 1111 1111 1111", IsCreditCard("a"), true, "valid Visa test number with spaces."},
+	{"4111111111111112", IsCreditCard("a"), false, "invalid Luhn checksum."},
+
+	// IsE164Phone
+	{"+14155552671", IsE164Phone("a"), true, "valid E.164 number."},
+	{"14155552671", IsE164Phone("a"), false, "missing leading +."},
+	{"+0123456789", IsE164Phone("a"), false, "leading digit cannot be 0."},
+
+	// IsISO3166Alpha2
+	{"US", IsISO3166Alpha2("a"), true, "US is a valid country code."},
+	{"us", IsISO3166Alpha2("a"), true, "country codes match case-insensitively."},
+	{"ZZ", IsISO3166Alpha2("a"), false, "ZZ is not an assigned country code."},
+
+	// IsSemVer
+	{"1.2.3", IsSemVer("a"), true, "valid semver."},
+	{"1.2.3-alpha.1+build.1", IsSemVer("a"), true, "valid semver with pre-release and build metadata."},
+	{"1.2", IsSemVer("a"), false, "invalid semver, missing patch."},
+	{"01.2.3", IsSemVer("a"), false, "semver.org forbids leading zeros in numeric identifiers."},
+	{"1.02.3", IsSemVer("a"), false, "semver.org forbids leading zeros in numeric identifiers."},
+	{"1.2.03", IsSemVer("a"), false, "semver.org forbids leading zeros in numeric identifiers."},
+	{"0.2.3", IsSemVer("a"), true, "a lone 0 is not a leading zero."},
+}
+
+func TestChecks(t *testing.T) {
+	var err error
+	for i, test := range checksTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Checks #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
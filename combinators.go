@@ -0,0 +1,297 @@
+package valid
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// stringCombinator implements StringValidator and ValidatorCtx for And/Or/Not/When, running
+// a check function that is given the active context.Context so it can thread ctx to its
+// children via validateStringCtx.
+type stringCombinator func(ctx context.Context, val string) error
+
+func (c stringCombinator) Validate(val string) error {
+	return c(context.Background(), val)
+}
+
+func (c stringCombinator) ValidateCtx(ctx context.Context, val string) error {
+	return c(ctx, val)
+}
+
+// andString runs v in order against val under ctx, returning the first error encountered.
+func andString(ctx context.Context, v []StringValidator, val string) error {
+	for _, validator := range v {
+		if err := validateStringCtx(ctx, validator, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// And creates a validator that runs the given validators in order and returns the first
+// error encountered, short-circuiting the rest. And consideres the value valid if all
+// given validators pass. The result implements ValidatorCtx, threading a context.Context
+// through to children that implement it (e.g. Msg-suffixed validators), so it composes with
+// StringCtx.
+func And(v ...StringValidator) StringValidator {
+	return stringCombinator(func(ctx context.Context, val string) error {
+		return andString(ctx, v, val)
+	})
+}
+
+// Or creates a validator that consideres the value valid if at least one of the given
+// validators passes. If all of them fail, Or returns an error with the given message. The
+// result implements ValidatorCtx like And.
+func Or(message string, v ...StringValidator) StringValidator {
+	return stringCombinator(func(ctx context.Context, val string) error {
+		for _, validator := range v {
+			if validateStringCtx(ctx, validator, val) == nil {
+				return nil
+			}
+		}
+		return errors.New(message)
+	})
+}
+
+// Not creates a validator that inverts the given validator: it consideres the value valid
+// if v fails, and returns an error with the given message if v passes. The result implements
+// ValidatorCtx like And.
+func Not(v StringValidator, message string) StringValidator {
+	return stringCombinator(func(ctx context.Context, val string) error {
+		if validateStringCtx(ctx, v, val) == nil {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// When creates a validator that only runs the given validators if pred(val) is true. If
+// pred returns false, the value is consideres valid without running v. The result implements
+// ValidatorCtx like And.
+func When(pred func(string) bool, v ...StringValidator) StringValidator {
+	return stringCombinator(func(ctx context.Context, val string) error {
+		if !pred(val) {
+			return nil
+		}
+		return andString(ctx, v, val)
+	})
+}
+
+// First applies a list of StringValidators to a string value like String does, but stops
+// and returns after the first failing validator instead of aggregating all errors.
+func First(val string, v ...StringValidator) []error {
+	for _, validator := range v {
+		if err := validator.Validate(val); err != nil {
+			return []error{err}
+		}
+	}
+	return []error{}
+}
+
+// intCombinator is the IntValidator equivalent of stringCombinator.
+type intCombinator func(ctx context.Context, val int) error
+
+func (c intCombinator) Validate(val int) error {
+	return c(context.Background(), val)
+}
+
+func (c intCombinator) ValidateCtx(ctx context.Context, val int) error {
+	return c(ctx, val)
+}
+
+func andInt(ctx context.Context, v []IntValidator, val int) error {
+	for _, validator := range v {
+		if err := validateIntCtx(ctx, validator, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AndInt is the IntValidator equivalent of And.
+func AndInt(v ...IntValidator) IntValidator {
+	return intCombinator(func(ctx context.Context, val int) error {
+		return andInt(ctx, v, val)
+	})
+}
+
+// OrInt is the IntValidator equivalent of Or.
+func OrInt(message string, v ...IntValidator) IntValidator {
+	return intCombinator(func(ctx context.Context, val int) error {
+		for _, validator := range v {
+			if validateIntCtx(ctx, validator, val) == nil {
+				return nil
+			}
+		}
+		return errors.New(message)
+	})
+}
+
+// NotInt is the IntValidator equivalent of Not.
+func NotInt(v IntValidator, message string) IntValidator {
+	return intCombinator(func(ctx context.Context, val int) error {
+		if validateIntCtx(ctx, v, val) == nil {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// WhenInt is the IntValidator equivalent of When.
+func WhenInt(pred func(int) bool, v ...IntValidator) IntValidator {
+	return intCombinator(func(ctx context.Context, val int) error {
+		if !pred(val) {
+			return nil
+		}
+		return andInt(ctx, v, val)
+	})
+}
+
+// FirstInt is the IntValidator equivalent of First.
+func FirstInt(val int, v ...IntValidator) []error {
+	for _, validator := range v {
+		if err := validator.Validate(val); err != nil {
+			return []error{err}
+		}
+	}
+	return []error{}
+}
+
+// float64Combinator is the Float64Validator equivalent of stringCombinator.
+type float64Combinator func(ctx context.Context, val float64) error
+
+func (c float64Combinator) Validate(val float64) error {
+	return c(context.Background(), val)
+}
+
+func (c float64Combinator) ValidateCtx(ctx context.Context, val float64) error {
+	return c(ctx, val)
+}
+
+func andFloat64(ctx context.Context, v []Float64Validator, val float64) error {
+	for _, validator := range v {
+		if err := validateFloat64Ctx(ctx, validator, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AndFloat64 is the Float64Validator equivalent of And.
+func AndFloat64(v ...Float64Validator) Float64Validator {
+	return float64Combinator(func(ctx context.Context, val float64) error {
+		return andFloat64(ctx, v, val)
+	})
+}
+
+// OrFloat64 is the Float64Validator equivalent of Or.
+func OrFloat64(message string, v ...Float64Validator) Float64Validator {
+	return float64Combinator(func(ctx context.Context, val float64) error {
+		for _, validator := range v {
+			if validateFloat64Ctx(ctx, validator, val) == nil {
+				return nil
+			}
+		}
+		return errors.New(message)
+	})
+}
+
+// NotFloat64 is the Float64Validator equivalent of Not.
+func NotFloat64(v Float64Validator, message string) Float64Validator {
+	return float64Combinator(func(ctx context.Context, val float64) error {
+		if validateFloat64Ctx(ctx, v, val) == nil {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// WhenFloat64 is the Float64Validator equivalent of When.
+func WhenFloat64(pred func(float64) bool, v ...Float64Validator) Float64Validator {
+	return float64Combinator(func(ctx context.Context, val float64) error {
+		if !pred(val) {
+			return nil
+		}
+		return andFloat64(ctx, v, val)
+	})
+}
+
+// FirstFloat64 is the Float64Validator equivalent of First.
+func FirstFloat64(val float64, v ...Float64Validator) []error {
+	for _, validator := range v {
+		if err := validator.Validate(val); err != nil {
+			return []error{err}
+		}
+	}
+	return []error{}
+}
+
+// timeCombinator is the TimeValidator equivalent of stringCombinator.
+type timeCombinator func(ctx context.Context, val time.Time) error
+
+func (c timeCombinator) Validate(val time.Time) error {
+	return c(context.Background(), val)
+}
+
+func (c timeCombinator) ValidateCtx(ctx context.Context, val time.Time) error {
+	return c(ctx, val)
+}
+
+func andTime(ctx context.Context, v []TimeValidator, val time.Time) error {
+	for _, validator := range v {
+		if err := validateTimeCtx(ctx, validator, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AndTime is the TimeValidator equivalent of And.
+func AndTime(v ...TimeValidator) TimeValidator {
+	return timeCombinator(func(ctx context.Context, val time.Time) error {
+		return andTime(ctx, v, val)
+	})
+}
+
+// OrTime is the TimeValidator equivalent of Or.
+func OrTime(message string, v ...TimeValidator) TimeValidator {
+	return timeCombinator(func(ctx context.Context, val time.Time) error {
+		for _, validator := range v {
+			if validateTimeCtx(ctx, validator, val) == nil {
+				return nil
+			}
+		}
+		return errors.New(message)
+	})
+}
+
+// NotTime is the TimeValidator equivalent of Not.
+func NotTime(v TimeValidator, message string) TimeValidator {
+	return timeCombinator(func(ctx context.Context, val time.Time) error {
+		if validateTimeCtx(ctx, v, val) == nil {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// WhenTime is the TimeValidator equivalent of When.
+func WhenTime(pred func(time.Time) bool, v ...TimeValidator) TimeValidator {
+	return timeCombinator(func(ctx context.Context, val time.Time) error {
+		if !pred(val) {
+			return nil
+		}
+		return andTime(ctx, v, val)
+	})
+}
+
+// FirstTime is the TimeValidator equivalent of First.
+func FirstTime(val time.Time, v ...TimeValidator) []error {
+	for _, validator := range v {
+		if err := validator.Validate(val); err != nil {
+			return []error{err}
+		}
+	}
+	return []error{}
+}
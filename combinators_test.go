@@ -0,0 +1,139 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+var combinatorTests = []stringTest{
+	// And
+	{"abcd", And(Nonempty("empty"), MaxLen(10, "too long")), true, "abcd passes both Nonempty and MaxLen."},
+	{"", And(Nonempty("empty"), MaxLen(10, "too long")), false, "And fails fast on Nonempty."},
+	{"abcdefghijk", And(Nonempty("empty"), MaxLen(10, "too long")), false, "And fails on MaxLen."},
+
+	// Or
+	{"abc", Or("no match", Email("not email"), Alphanumeric("not alphanumeric")), true, "abc is alphanumeric."},
+	{"john@example.com", Or("no match", Email("not email"), Alphanumeric("not alphanumeric")), true, "john@example.com is an email."},
+	{"%$#", Or("no match", Email("not email"), Alphanumeric("not alphanumeric")), false, "%$# matches neither."},
+
+	// Not
+	{"", Not(Nonempty("should not be empty"), "should be empty"), true, "Not(Nonempty) passes on empty string."},
+	{"abc", Not(Nonempty("should not be empty"), "should be empty"), false, "Not(Nonempty) fails on non-empty string."},
+}
+
+func TestCombinators(t *testing.T) {
+	var err error
+	for i, test := range combinatorTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Combinator #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
+
+func TestWhen(t *testing.T) {
+	isLong := func(val string) bool { return len(val) > 5 }
+	v := When(isLong, Alphanumeric("must be alphanumeric when long"))
+
+	if err := v.Validate("abc"); err != nil {
+		t.Errorf("short strings should skip the predicate's validators, got error: %v", err)
+	}
+	if err := v.Validate("abcdef"); err != nil {
+		t.Errorf("long alphanumeric string should pass, got error: %v", err)
+	}
+	if err := v.Validate("abc-def"); err == nil {
+		t.Errorf("long non-alphanumeric string should fail")
+	}
+}
+
+func TestFirst(t *testing.T) {
+	errs := First("", Nonempty("is empty"), MaxLen(1, "too long"))
+	if len(errs) != 1 {
+		t.Fatalf("First should stop after the first failure, got %d errors", len(errs))
+	}
+	if errs[0].Error() != "is empty" {
+		t.Errorf("First() returns the wrong message: %q instead of %q", errs[0].Error(), "is empty")
+	}
+
+	if errs := First("ok", Nonempty("is empty"), MaxLen(10, "too long")); len(errs) != 0 {
+		t.Errorf("First should return no errors when all validators pass, got %v", errs)
+	}
+}
+
+func TestTypedCombinators(t *testing.T) {
+	// AndInt / OrInt / NotInt / WhenInt
+	if err := AndInt(IntAtLeast(0, "negative"), IntAtMost(10, "too big")).Validate(5); err != nil {
+		t.Errorf("AndInt: 5 should satisfy both validators, got error: %v", err)
+	}
+	if err := AndInt(IntAtLeast(0, "negative"), IntAtMost(10, "too big")).Validate(-1); err == nil {
+		t.Errorf("AndInt: -1 should fail IntAtLeast(0)")
+	}
+
+	if err := OrInt("no match", IntAtMost(0, "not <=0"), IntAtLeast(10, "not >=10")).Validate(20); err != nil {
+		t.Errorf("OrInt: 20 satisfies IntAtLeast(10), got error: %v", err)
+	}
+	if err := OrInt("no match", IntAtMost(0, "not <=0"), IntAtLeast(10, "not >=10")).Validate(5); err == nil {
+		t.Errorf("OrInt: 5 satisfies neither validator, should fail")
+	}
+
+	if err := NotInt(IntAtLeast(18, "adult"), "must be a minor").Validate(10); err != nil {
+		t.Errorf("NotInt: 10 is not an adult, should pass, got error: %v", err)
+	}
+	if err := NotInt(IntAtLeast(18, "adult"), "must be a minor").Validate(20); err == nil {
+		t.Errorf("NotInt: 20 is an adult, should fail")
+	}
+
+	isEven := func(val int) bool { return val%2 == 0 }
+	whenV := WhenInt(isEven, IntAtLeast(10, "even numbers must be at least 10"))
+	if err := whenV.Validate(3); err != nil {
+		t.Errorf("WhenInt: odd numbers should skip the predicate's validators, got error: %v", err)
+	}
+	if err := whenV.Validate(4); err == nil {
+		t.Errorf("WhenInt: 4 is even and below 10, should fail")
+	}
+
+	if errs := FirstInt(4, IntAtLeast(10, "too small"), IntAtMost(0, "too big")); len(errs) != 1 || errs[0].Error() != "too small" {
+		t.Errorf("FirstInt should stop after the first failure, got %v", errs)
+	}
+
+	// AndFloat64 / OrFloat64 / NotFloat64 / WhenFloat64 / FirstFloat64
+	if err := AndFloat64(FloatAtLeast(0, "negative"), FloatAtMost(10, "too big")).Validate(5.5); err != nil {
+		t.Errorf("AndFloat64: 5.5 should satisfy both validators, got error: %v", err)
+	}
+	if err := OrFloat64("no match", FloatAtMost(0, "not <=0"), FloatAtLeast(10, "not >=10")).Validate(5); err == nil {
+		t.Errorf("OrFloat64: 5 satisfies neither validator, should fail")
+	}
+	if err := NotFloat64(FloatAtLeast(18, "adult"), "must be a minor").Validate(20); err == nil {
+		t.Errorf("NotFloat64: 20 is an adult, should fail")
+	}
+	isPositive := func(val float64) bool { return val > 0 }
+	if err := WhenFloat64(isPositive, FloatAtLeast(10, "too small")).Validate(-5); err != nil {
+		t.Errorf("WhenFloat64: negative numbers should skip the predicate's validators, got error: %v", err)
+	}
+	if errs := FirstFloat64(4, FloatAtLeast(10, "too small"), FloatAtMost(0, "too big")); len(errs) != 1 || errs[0].Error() != "too small" {
+		t.Errorf("FirstFloat64 should stop after the first failure, got %v", errs)
+	}
+
+	// AndTime / OrTime / NotTime / WhenTime / FirstTime
+	now := time.Date(2020, time.June, 15, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	if err := AndTime(TimeAfter(past, "too early"), TimeBefore(future, "too late")).Validate(now); err != nil {
+		t.Errorf("AndTime: now should be after past and before future, got error: %v", err)
+	}
+	if err := OrTime("no match", TimeBefore(past, "not before past"), TimeAfter(past, "not after past")).Validate(now); err != nil {
+		t.Errorf("OrTime: now is after past, got error: %v", err)
+	}
+	if err := NotTime(TimeAfter(past, "after past"), "must not be after past").Validate(now); err == nil {
+		t.Errorf("NotTime: now is after past, should fail")
+	}
+	isAfterNow := func(val time.Time) bool { return val.After(now) }
+	if err := WhenTime(isAfterNow, TimeBefore(past, "too early")).Validate(past); err != nil {
+		t.Errorf("WhenTime: past is not after now, should skip the predicate's validators, got error: %v", err)
+	}
+	if errs := FirstTime(past, TimeAfter(now, "too early"), TimeAfter(future, "too late")); len(errs) != 1 || errs[0].Error() != "too early" {
+		t.Errorf("FirstTime should stop after the first failure, got %v", errs)
+	}
+}
@@ -29,5 +29,56 @@ A convenience type is also defined: `type StringFunc func(val string) error`. Th
 `StringValidator` interface, so any function with the above signature can be used as a validator.
 
 You can see code for a custom validator here https://gist.github.com/vladvelici/00679f8dff9e205cc157.
+
+Other types
+
+The same pattern is repeated for other basic types: `IntValidator`/`IntFunc` and `Int(...)` for int,
+`Float64Validator`/`Float64Func` and `Float64(...)` for float64, and `TimeValidator`/`TimeFunc` and
+`Time(...)` for time.Time.
+
+Validating multiple fields
+
+`valid.Fields()` returns a builder that validates several fields of a payload and aggregates the
+results into `Errors`, a slice of `FieldError` keyed by field name:
+
+    errs := valid.Fields().
+        String("email", email, valid.Email("bad email")).
+        Int("age", age, valid.IntAtLeast(18, "too young")).
+        Errors()
+
+`valid.Struct(v)` does the same thing by reading `valid:"..."` struct tags via reflection, for
+callers that would rather describe validation on the struct definition itself.
+
+Combinators
+
+`And`, `Or`, `Not` and `When` combine existing StringValidators into new ones, so conditional
+rules can be expressed without writing a custom function each time:
+
+    v := valid.When(isPremiumUser, valid.And(valid.Nonempty("required"), valid.MaxLen(100, "too long")))
+
+`First` mirrors `String` but stops and returns after the first failing validator, instead of
+aggregating every error.
+
+The same combinators are available for the typed validators, suffixed by type: `AndInt`,
+`OrInt`, `NotInt`, `WhenInt`, `FirstInt` for IntValidator, and the `Float64`/`Time`-suffixed
+equivalents for Float64Validator and TimeValidator.
+
+Localized messages
+
+`Msg`-suffixed constructors, such as `NonemptyMsg` and `MaxLenMsg`, take a `Message` instead of
+a plain string. A `Message` is either a literal (`valid.Lit("...")`) or a lookup key
+(`valid.Key("...", args...)`) resolved against a `Translator`: the package-level `Catalog` set
+with `SetCatalog`, or, per request, one attached to a `context.Context` with `WithTranslator`
+and read by `StringCtx`. The resulting errors are `*ValidationError`, which carry an `ErrCode`
+alongside the resolved message.
+
+Unicode-aware length and rich string checks
+
+`Len`/`MinLen`/`MaxLen` count bytes, which is wrong for user-facing text containing multi-byte
+runes. `RuneLen`/`MinRuneLen`/`MaxRuneLen` count runes instead, and the `valid/text` subpackage
+adds `GraphemeLen`/`MinGraphemeLen`/`MaxGraphemeLen`, which approximate grapheme cluster counting
+by not counting nonspacing combining marks after NFC normalisation. This package
+also ships semantic string checks for common formats: `IsUUID`, `IsULID`, `IsBase64`,
+`IsHexColor`, `IsJSON`, `IsCreditCard`, `IsE164Phone`, `IsISO3166Alpha2` and `IsSemVer`.
 */
 package valid
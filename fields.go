@@ -0,0 +1,90 @@
+package valid
+
+import "time"
+
+// FieldError associates a validation error with the name of the field it came from.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error returns the underlying error's message.
+func (f FieldError) Error() string {
+	return f.Err.Error()
+}
+
+// Errors is a collection of FieldError, in the order they were added.
+type Errors []FieldError
+
+// Error implements the error interface by joining all field errors into a single message.
+func (e Errors) Error() string {
+	msg := ""
+	for i, fe := range e {
+		if i > 0 {
+			msg += "; "
+		}
+		msg += fe.Field + ": " + fe.Err.Error()
+	}
+	return msg
+}
+
+// ByField groups the errors by field name, preserving the order in which they were added.
+func (e Errors) ByField() map[string][]error {
+	byField := make(map[string][]error)
+	for _, fe := range e {
+		byField[fe.Field] = append(byField[fe.Field], fe.Err)
+	}
+	return byField
+}
+
+// FieldsBuilder accumulates FieldError values across validation of multiple fields. Use
+// Fields() to create one, chain calls per field, then call Errors() to get the result.
+type FieldsBuilder struct {
+	errs Errors
+}
+
+// Fields creates a new FieldsBuilder to validate several fields and aggregate their errors.
+func Fields() *FieldsBuilder {
+	return &FieldsBuilder{}
+}
+
+// String validates val against the given StringValidators and appends any errors under the
+// given field name.
+func (b *FieldsBuilder) String(field, val string, v ...StringValidator) *FieldsBuilder {
+	for _, err := range String(val, v...) {
+		b.errs = append(b.errs, FieldError{Field: field, Err: err})
+	}
+	return b
+}
+
+// Int validates val against the given IntValidators and appends any errors under the given
+// field name.
+func (b *FieldsBuilder) Int(field string, val int, v ...IntValidator) *FieldsBuilder {
+	for _, err := range Int(val, v...) {
+		b.errs = append(b.errs, FieldError{Field: field, Err: err})
+	}
+	return b
+}
+
+// Float64 validates val against the given Float64Validators and appends any errors under the
+// given field name.
+func (b *FieldsBuilder) Float64(field string, val float64, v ...Float64Validator) *FieldsBuilder {
+	for _, err := range Float64(val, v...) {
+		b.errs = append(b.errs, FieldError{Field: field, Err: err})
+	}
+	return b
+}
+
+// Time validates val against the given TimeValidators and appends any errors under the given
+// field name.
+func (b *FieldsBuilder) Time(field string, val time.Time, v ...TimeValidator) *FieldsBuilder {
+	for _, err := range Time(val, v...) {
+		b.errs = append(b.errs, FieldError{Field: field, Err: err})
+	}
+	return b
+}
+
+// Errors returns the aggregated field errors collected so far.
+func (b *FieldsBuilder) Errors() Errors {
+	return b.errs
+}
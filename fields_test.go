@@ -0,0 +1,45 @@
+package valid
+
+import (
+	"testing"
+)
+
+func TestFieldsBuilder(t *testing.T) {
+	errs := Fields().
+		String("email", "not-an-email", Email("bad email")).
+		Int("age", 10, IntAtLeast(18, "too young")).
+		String("name", "ok", Nonempty("required")).
+		Errors()
+
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 field errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "email" || errs[0].Err.Error() != "bad email" {
+		t.Errorf("unexpected first error: %#v", errs[0])
+	}
+	if errs[1].Field != "age" || errs[1].Err.Error() != "too young" {
+		t.Errorf("unexpected second error: %#v", errs[1])
+	}
+}
+
+func TestErrorsByField(t *testing.T) {
+	errs := Fields().
+		String("email", "", Nonempty("required")).
+		String("email", "", Email("bad email")).
+		Errors()
+
+	byField := errs.ByField()
+	if len(byField["email"]) != 2 {
+		t.Errorf("expected 2 errors for field \"email\", got %d", len(byField["email"]))
+	}
+}
+
+func TestErrorsError(t *testing.T) {
+	errs := Fields().
+		String("email", "", Nonempty("required")).
+		Errors()
+
+	if errs.Error() != "email: required" {
+		t.Errorf("unexpected Errors.Error(): %q", errs.Error())
+	}
+}
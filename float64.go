@@ -0,0 +1,68 @@
+package valid
+
+import "errors"
+
+// Float64Validator interface is implemented by all float64 validators.
+type Float64Validator interface {
+	// Validate validates the given parameter and returns a validation error, or nil
+	// if the input is valid.
+	Validate(float64) error
+}
+
+// A Float64Func takes a value to validate and returns a validation error.
+//
+// This type implements the Float64Validator interface, thus any functions with this
+// signature can be casted to Float64Func and used as a Float64Validator.
+type Float64Func func(val float64) error
+
+// Validate function of Float64Func
+func (f Float64Func) Validate(val float64) error {
+	return f(val)
+}
+
+// Float64 applies a list of Float64Validators to a float64 value and returns a list of
+// aggregated errors.
+func Float64(val float64, v ...Float64Validator) []error {
+	errors := make([]error, 0)
+	for _, validator := range v {
+		err := validator.Validate(val)
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
+
+// FloatBetween creates a validator that checks if the given float64 is in the closed interval [min, max].
+// It includes min and max: all val that satisfy max >= val >= min are considered valid.
+func FloatBetween(min, max float64, message string) Float64Validator {
+	f := func(val float64) error {
+		if val < min || val > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return Float64Func(f)
+}
+
+// FloatAtLeast creates a validator that consideres all float64 values valid if they satisfy val >= min.
+func FloatAtLeast(min float64, message string) Float64Validator {
+	f := func(val float64) error {
+		if val < min {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return Float64Func(f)
+}
+
+// FloatAtMost creates a validator that consideres all float64 values valid if they satisfy val <= max.
+func FloatAtMost(max float64, message string) Float64Validator {
+	f := func(val float64) error {
+		if val > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return Float64Func(f)
+}
@@ -0,0 +1,51 @@
+package valid
+
+import (
+	"testing"
+)
+
+type float64Test struct {
+	input      float64
+	validator  Float64Validator
+	shouldPass bool
+	message    string
+}
+
+var float64Tests = []float64Test{
+	// FloatBetween
+	{5.5, FloatBetween(1, 10, "a"), true, "5.5 is in range [1,10]."},
+	{1, FloatBetween(1, 10, "a"), true, "1 is in range [1,10]."},
+	{10, FloatBetween(1, 10, "a"), true, "10 is in range [1,10]."},
+	{0.9, FloatBetween(1, 10, "a"), false, "0.9 is not in range [1,10]."},
+	{10.1, FloatBetween(1, 10, "a"), false, "10.1 is not in range [1,10]."},
+
+	// FloatAtLeast
+	{5, FloatAtLeast(5, "a"), true, "5 is at least 5."},
+	{4.9, FloatAtLeast(5, "a"), false, "4.9 is not at least 5."},
+
+	// FloatAtMost
+	{5, FloatAtMost(5, "a"), true, "5 is at most 5."},
+	{5.1, FloatAtMost(5, "a"), false, "5.1 is not at most 5."},
+}
+
+func TestFloat64Validators(t *testing.T) {
+	var err error
+	for i, test := range float64Tests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Float64 #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	errs := Float64(3.5, FloatAtLeast(0, "this should pass"), FloatAtMost(3, "this should fail"))
+	if len(errs) != 1 {
+		t.Errorf("Float64 function returns the wrong number of errors: %d instead of 1.", len(errs))
+		t.FailNow()
+	}
+	if errs[0].Error() != "this should fail" {
+		t.Errorf("Float64() returns the wrong message: errs[0] = %#v instead of %#v", errs[0].Error(), "this should fail")
+	}
+}
@@ -0,0 +1,220 @@
+package valid
+
+import (
+	"context"
+	"time"
+)
+
+// Message represents a validation message that is either a literal string or a lookup key
+// resolved against a Catalog or, for context-aware callers, a per-request Translator.
+type Message struct {
+	text string
+	key  string
+	args []interface{}
+}
+
+// Lit creates a literal Message. Its text is used as-is and is never looked up in a Catalog.
+func Lit(text string) Message {
+	return Message{text: text}
+}
+
+// Key creates a Message that is resolved by looking key up in the active Translator or
+// Catalog, passing args along as template parameters. If no translation is available, key
+// itself is used as the message.
+func Key(key string, args ...interface{}) Message {
+	return Message{key: key, args: args}
+}
+
+// Resolve returns the message text, resolving Key messages against the package-level
+// Catalog. Use ResolveCtx to resolve against a per-request Translator instead.
+func (m Message) Resolve() string {
+	return m.ResolveCtx(context.Background())
+}
+
+// ResolveCtx returns the message text, resolving Key messages against the Translator
+// carried by ctx (see WithTranslator), falling back to the package-level Catalog, and
+// finally to the key itself if neither is set.
+func (m Message) ResolveCtx(ctx context.Context) string {
+	if m.key == "" {
+		return m.text
+	}
+	if t := translatorFromContext(ctx); t != nil {
+		return t(m.key, m.args...)
+	}
+	return m.key
+}
+
+// Translator resolves a message key, with template args, to localized text. It has the same
+// shape as the function passed to SetCatalog, but can be attached to a context.Context with
+// WithTranslator so different requests can use different locales.
+type Translator func(key string, args ...interface{}) string
+
+// Catalog is the package-level fallback Translator, used to resolve Key messages when the
+// context passed to ResolveCtx/ValidateCtx/StringCtx does not carry its own Translator. It
+// is nil by default, in which case Key messages resolve to their own key.
+var Catalog Translator
+
+// SetCatalog sets the package-level fallback Translator.
+func SetCatalog(t func(key string, args ...interface{}) string) {
+	Catalog = t
+}
+
+type translatorCtxKey struct{}
+
+// WithTranslator returns a copy of ctx carrying t as its Translator, so validators resolved
+// via ResolveCtx/ValidateCtx/StringCtx use t in preference to the package-level Catalog.
+func WithTranslator(ctx context.Context, t Translator) context.Context {
+	return context.WithValue(ctx, translatorCtxKey{}, t)
+}
+
+func translatorFromContext(ctx context.Context) Translator {
+	if t, ok := ctx.Value(translatorCtxKey{}).(Translator); ok && t != nil {
+		return t
+	}
+	return Catalog
+}
+
+// ValidationError is the error type returned by Msg-suffixed validators. It carries a
+// machine-readable ErrCode alongside the human-readable Message, so callers can render
+// per-locale text while still branching on the failure kind.
+type ValidationError struct {
+	ErrCode string
+	Message Message
+}
+
+// Error implements the error interface by resolving Message against the package-level
+// Catalog. Use ValidateCtx-based validators to resolve against a per-request Translator.
+func (e *ValidationError) Error() string {
+	return e.Message.Resolve()
+}
+
+// ValidatorCtx is implemented by StringValidators whose Validate behavior can take a
+// context.Context, e.g. to resolve a Key Message via a per-request Translator (see
+// WithTranslator). StringCtx prefers ValidateCtx over Validate when a validator implements
+// it.
+type ValidatorCtx interface {
+	ValidateCtx(ctx context.Context, val string) error
+}
+
+// StringCtx applies a list of StringValidators to a string value, like String, but threads
+// ctx through to any validator implementing ValidatorCtx, so Msg-suffixed validators can
+// resolve localized messages per request.
+func StringCtx(ctx context.Context, val string, v ...StringValidator) []error {
+	errs := make([]error, 0)
+	for _, validator := range v {
+		if err := validateStringCtx(ctx, validator, val); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateStringCtx validates val with v, preferring ValidateCtx over Validate when v
+// implements ValidatorCtx. And/Or/Not/When use this to thread ctx to their children.
+func validateStringCtx(ctx context.Context, v StringValidator, val string) error {
+	if vc, ok := v.(ValidatorCtx); ok {
+		return vc.ValidateCtx(ctx, val)
+	}
+	return v.Validate(val)
+}
+
+// IntValidatorCtx is the IntValidator equivalent of ValidatorCtx.
+type IntValidatorCtx interface {
+	ValidateCtx(ctx context.Context, val int) error
+}
+
+// validateIntCtx is the IntValidator equivalent of validateStringCtx.
+func validateIntCtx(ctx context.Context, v IntValidator, val int) error {
+	if vc, ok := v.(IntValidatorCtx); ok {
+		return vc.ValidateCtx(ctx, val)
+	}
+	return v.Validate(val)
+}
+
+// Float64ValidatorCtx is the Float64Validator equivalent of ValidatorCtx.
+type Float64ValidatorCtx interface {
+	ValidateCtx(ctx context.Context, val float64) error
+}
+
+// validateFloat64Ctx is the Float64Validator equivalent of validateStringCtx.
+func validateFloat64Ctx(ctx context.Context, v Float64Validator, val float64) error {
+	if vc, ok := v.(Float64ValidatorCtx); ok {
+		return vc.ValidateCtx(ctx, val)
+	}
+	return v.Validate(val)
+}
+
+// TimeValidatorCtx is the TimeValidator equivalent of ValidatorCtx.
+type TimeValidatorCtx interface {
+	ValidateCtx(ctx context.Context, val time.Time) error
+}
+
+// validateTimeCtx is the TimeValidator equivalent of validateStringCtx.
+func validateTimeCtx(ctx context.Context, v TimeValidator, val time.Time) error {
+	if vc, ok := v.(TimeValidatorCtx); ok {
+		return vc.ValidateCtx(ctx, val)
+	}
+	return v.Validate(val)
+}
+
+// msgStringValidator implements StringValidator and ValidatorCtx for the Msg-suffixed
+// constructors below, wrapping a predicate with a code and a localizable Message.
+type msgStringValidator struct {
+	code  string
+	msg   Message
+	check func(string) bool
+}
+
+func (v msgStringValidator) Validate(val string) error {
+	if v.check(val) {
+		return nil
+	}
+	return &ValidationError{ErrCode: v.code, Message: v.msg}
+}
+
+func (v msgStringValidator) ValidateCtx(ctx context.Context, val string) error {
+	if v.check(val) {
+		return nil
+	}
+	return &ValidationError{ErrCode: v.code, Message: Lit(v.msg.ResolveCtx(ctx))}
+}
+
+// NonemptyMsg is like Nonempty but takes a localizable Message and returns a *ValidationError
+// carrying the "nonempty" ErrCode.
+func NonemptyMsg(msg Message) StringValidator {
+	return msgStringValidator{code: "nonempty", msg: msg, check: func(val string) bool {
+		return val != ""
+	}}
+}
+
+// MaxLenMsg is like MaxLen but takes a localizable Message and returns a *ValidationError
+// carrying the "maxlen" ErrCode.
+func MaxLenMsg(max int, msg Message) StringValidator {
+	return msgStringValidator{code: "maxlen", msg: msg, check: func(val string) bool {
+		return len(val) <= max
+	}}
+}
+
+// MinLenMsg is like MinLen but takes a localizable Message and returns a *ValidationError
+// carrying the "minlen" ErrCode.
+func MinLenMsg(min int, msg Message) StringValidator {
+	return msgStringValidator{code: "minlen", msg: msg, check: func(val string) bool {
+		return len(val) >= min
+	}}
+}
+
+// LenMsg is like Len but takes a localizable Message and returns a *ValidationError
+// carrying the "len" ErrCode.
+func LenMsg(min, max int, msg Message) StringValidator {
+	return msgStringValidator{code: "len", msg: msg, check: func(val string) bool {
+		return len(val) >= min && len(val) <= max
+	}}
+}
+
+// EmailMsg is like Email but takes a localizable Message and returns a *ValidationError
+// carrying the "email" ErrCode.
+func EmailMsg(msg Message) StringValidator {
+	return msgStringValidator{code: "email", msg: msg, check: func(val string) bool {
+		return RegEmail.MatchString(val)
+	}}
+}
@@ -0,0 +1,156 @@
+package valid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMessageLit(t *testing.T) {
+	msg := Lit("literal message")
+	if msg.Resolve() != "literal message" {
+		t.Errorf("Lit message should resolve to its own text, got %q", msg.Resolve())
+	}
+}
+
+func TestMessageKeyNoCatalog(t *testing.T) {
+	Catalog = nil
+	msg := Key("field.required")
+	if msg.Resolve() != "field.required" {
+		t.Errorf("Key message with no Catalog should resolve to its own key, got %q", msg.Resolve())
+	}
+}
+
+func TestMessageKeyWithCatalog(t *testing.T) {
+	SetCatalog(func(key string, args ...interface{}) string {
+		if key == "field.required" {
+			return "this field is required"
+		}
+		return key
+	})
+	defer SetCatalog(nil)
+
+	msg := Key("field.required")
+	if msg.Resolve() != "this field is required" {
+		t.Errorf("Key message should resolve via Catalog, got %q", msg.Resolve())
+	}
+}
+
+func TestMessageResolveCtxPrefersTranslator(t *testing.T) {
+	SetCatalog(func(key string, args ...interface{}) string { return "from catalog" })
+	defer SetCatalog(nil)
+
+	ctx := WithTranslator(context.Background(), func(key string, args ...interface{}) string {
+		return "from translator"
+	})
+
+	msg := Key("field.required")
+	if got := msg.ResolveCtx(ctx); got != "from translator" {
+		t.Errorf("ResolveCtx should prefer the context Translator over Catalog, got %q", got)
+	}
+	if got := msg.Resolve(); got != "from catalog" {
+		t.Errorf("Resolve (no ctx) should fall back to Catalog, got %q", got)
+	}
+}
+
+func TestNonemptyMsg(t *testing.T) {
+	v := NonemptyMsg(Lit("required"))
+
+	if err := v.Validate("hello"); err != nil {
+		t.Errorf("non-empty string should pass, got error: %v", err)
+	}
+
+	err := v.Validate("")
+	if err == nil {
+		t.Fatal("empty string should fail")
+	}
+	if err.Error() != "required" {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if verr.ErrCode != "nonempty" {
+		t.Errorf("unexpected ErrCode: %q", verr.ErrCode)
+	}
+}
+
+func TestStringCtx(t *testing.T) {
+	ctx := WithTranslator(context.Background(), func(key string, args ...interface{}) string {
+		return "champ requis"
+	})
+
+	errs := StringCtx(ctx, "", NonemptyMsg(Key("field.required")), MaxLenMsg(10, Lit("too long")))
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Error() != "champ requis" {
+		t.Errorf("StringCtx should resolve messages via the context Translator, got %q", errs[0].Error())
+	}
+}
+
+func TestStringCtxThroughCombinators(t *testing.T) {
+	ctx := WithTranslator(context.Background(), func(key string, args ...interface{}) string {
+		return "champ requis"
+	})
+
+	// And and When both surface the wrapped Msg validator's own error, so their result
+	// should carry the context-translated message rather than the raw key.
+	tests := []struct {
+		name string
+		v    StringValidator
+	}{
+		{"And", And(NonemptyMsg(Key("field.required")))},
+		{"When", When(func(string) bool { return true }, NonemptyMsg(Key("field.required")))},
+	}
+
+	for _, test := range tests {
+		errs := StringCtx(ctx, "", test.v)
+		if len(errs) != 1 {
+			t.Fatalf("%s: expected 1 error, got %d: %v", test.name, len(errs), errs)
+		}
+		if errs[0].Error() != "champ requis" {
+			t.Errorf("%s wrapped in StringCtx should resolve the Key message via the context Translator, got %q instead of the translated text", test.name, errs[0].Error())
+		}
+	}
+}
+
+func TestNotCtxThreadsContextToChild(t *testing.T) {
+	// Not's own error message is a plain string, but it must still call its child's
+	// ValidateCtx (rather than Validate) when threaded through StringCtx, so that any
+	// context-dependent behavior in the child (e.g. resolving a Key Message) still runs
+	// under the right context.
+	ctx := WithTranslator(context.Background(), func(key string, args ...interface{}) string { return key })
+	var gotCtx context.Context
+	child := stringCombinator(func(c context.Context, val string) error {
+		gotCtx = c
+		return NonemptyMsg(Lit("required")).Validate(val)
+	})
+
+	errs := StringCtx(ctx, "abc", Not(child, "must be empty"))
+	if gotCtx != ctx {
+		t.Fatal("Not should invoke its child's ValidateCtx with the StringCtx context")
+	}
+	if len(errs) != 1 || errs[0].Error() != "must be empty" {
+		t.Errorf("Not(child, \"must be empty\") on a non-empty child pass should fail with its own message, got %v", errs)
+	}
+}
+
+func TestOrCtxThreadsContextToChild(t *testing.T) {
+	// Or's own error message is a plain string when every child fails, but each child should
+	// still be reached via ValidateCtx when threaded through StringCtx.
+	ctx := WithTranslator(context.Background(), func(key string, args ...interface{}) string { return key })
+	var gotCtx context.Context
+	child := stringCombinator(func(c context.Context, val string) error {
+		gotCtx = c
+		return NonemptyMsg(Lit("required")).Validate(val)
+	})
+
+	errs := StringCtx(ctx, "", Or("all failed", child))
+	if gotCtx != ctx {
+		t.Fatal("Or should invoke its child's ValidateCtx with the StringCtx context")
+	}
+	if len(errs) != 1 || errs[0].Error() != "all failed" {
+		t.Errorf("Or should fail with its own message when every child fails, got %v", errs)
+	}
+}
@@ -0,0 +1,94 @@
+package valid
+
+import "errors"
+
+// IntValidator interface is implemented by all int validators.
+type IntValidator interface {
+	// Validate validates the given parameter and returns a validation error, or nil
+	// if the input is valid.
+	Validate(int) error
+}
+
+// An IntFunc takes a value to validate and returns a validation error.
+//
+// This type implements the IntValidator interface, thus any functions with this
+// signature can be casted to IntFunc and used as an IntValidator.
+type IntFunc func(val int) error
+
+// Validate function of IntFunc
+func (f IntFunc) Validate(val int) error {
+	return f(val)
+}
+
+// Int applies a list of IntValidators to an int value and returns a list of
+// aggregated errors.
+func Int(val int, v ...IntValidator) []error {
+	errors := make([]error, 0)
+	for _, validator := range v {
+		err := validator.Validate(val)
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
+
+// IntBetween creates a validator that checks if the given int is in the closed interval [min, max].
+// It includes min and max: all val that satisfy max >= val >= min are considered valid.
+func IntBetween(min, max int, message string) IntValidator {
+	f := func(val int) error {
+		if val < min || val > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return IntFunc(f)
+}
+
+// IntAtLeast creates a validator that consideres all int values valid if they satisfy val >= min.
+func IntAtLeast(min int, message string) IntValidator {
+	f := func(val int) error {
+		if val < min {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return IntFunc(f)
+}
+
+// IntAtMost creates a validator that consideres all int values valid if they satisfy val <= max.
+func IntAtMost(max int, message string) IntValidator {
+	f := func(val int) error {
+		if val > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return IntFunc(f)
+}
+
+// IntInSlice creates a validator that consideres an int value valid if it is equal to one of the
+// values given in the slice.
+func IntInSlice(slice []int, message string) IntValidator {
+	f := func(val int) error {
+		for _, v := range slice {
+			if v == val {
+				return nil
+			}
+		}
+		return errors.New(message)
+	}
+	return IntFunc(f)
+}
+
+// IntDivisibleBy creates a validator that consideres an int value valid if it is evenly
+// divisible by the given divisor.
+func IntDivisibleBy(divisor int, message string) IntValidator {
+	f := func(val int) error {
+		if val%divisor != 0 {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return IntFunc(f)
+}
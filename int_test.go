@@ -0,0 +1,59 @@
+package valid
+
+import (
+	"testing"
+)
+
+type intTest struct {
+	input      int
+	validator  IntValidator
+	shouldPass bool
+	message    string
+}
+
+var intTests = []intTest{
+	// IntBetween
+	{5, IntBetween(1, 10, "a"), true, "5 is in range [1,10]."},
+	{1, IntBetween(1, 10, "a"), true, "1 is in range [1,10]."},
+	{10, IntBetween(1, 10, "a"), true, "10 is in range [1,10]."},
+	{0, IntBetween(1, 10, "a"), false, "0 is not in range [1,10]."},
+	{11, IntBetween(1, 10, "a"), false, "11 is not in range [1,10]."},
+
+	// IntAtLeast
+	{5, IntAtLeast(5, "a"), true, "5 is at least 5."},
+	{4, IntAtLeast(5, "a"), false, "4 is not at least 5."},
+
+	// IntAtMost
+	{5, IntAtMost(5, "a"), true, "5 is at most 5."},
+	{6, IntAtMost(5, "a"), false, "6 is not at most 5."},
+
+	// IntInSlice
+	{2, IntInSlice([]int{1, 2, 3}, "a"), true, "2 is in [1,2,3]."},
+	{4, IntInSlice([]int{1, 2, 3}, "a"), false, "4 is not in [1,2,3]."},
+
+	// IntDivisibleBy
+	{9, IntDivisibleBy(3, "a"), true, "9 is divisible by 3."},
+	{10, IntDivisibleBy(3, "a"), false, "10 is not divisible by 3."},
+}
+
+func TestIntValidators(t *testing.T) {
+	var err error
+	for i, test := range intTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Int #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
+
+func TestInt(t *testing.T) {
+	errs := Int(25, IntAtLeast(18, "too young"), IntAtMost(20, "this should fail"), IntDivisibleBy(5, "this should pass"))
+	if len(errs) != 1 {
+		t.Errorf("Int function returns the wrong number of errors: %d instead of 1.", len(errs))
+		t.FailNow()
+	}
+	if errs[0].Error() != "this should fail" {
+		t.Errorf("Int() returns the wrong message: errs[0] = %#v instead of %#v", errs[0].Error(), "this should fail")
+	}
+}
@@ -0,0 +1,93 @@
+package valid
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+// IsIPAddress creates a validator that consideres a string valid if it is an IPv4 or IPv6 address.
+func IsIPAddress(message string) StringValidator {
+	f := func(val string) error {
+		if net.ParseIP(val) == nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsIPv4Address creates a validator that consideres a string valid if it is an IPv4 address.
+func IsIPv4Address(message string) StringValidator {
+	f := func(val string) error {
+		ip := net.ParseIP(val)
+		if ip == nil || ip.To4() == nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsIPv6Address creates a validator that consideres a string valid if it is an IPv6 address.
+func IsIPv6Address(message string) StringValidator {
+	f := func(val string) error {
+		ip := net.ParseIP(val)
+		if ip == nil || ip.To4() != nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsCIDR creates a validator that consideres a string valid if it is a valid CIDR notation
+// IP address and prefix length, such as "192.0.2.0/24".
+func IsCIDR(message string) StringValidator {
+	f := func(val string) error {
+		if _, _, err := net.ParseCIDR(val); err != nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsMACAddress creates a validator that consideres a string valid if it is a valid IEEE 802 MAC-48,
+// EUI-48, EUI-64, or a 20-octet IP over InfiniBand link-layer address.
+func IsMACAddress(message string) StringValidator {
+	f := func(val string) error {
+		if _, err := net.ParseMAC(val); err != nil {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsPortNumber creates a validator that consideres a string valid if it represents a valid
+// TCP/UDP port number in the range [0, 65535].
+func IsPortNumber(message string) StringValidator {
+	f := func(val string) error {
+		port, err := strconv.Atoi(val)
+		if err != nil || port < 0 || port > 65535 {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// IsURL creates a validator that consideres a string valid if it is a parseable absolute URL
+// with a non-empty scheme and host.
+func IsURL(message string) StringValidator {
+	f := func(val string) error {
+		u, err := url.Parse(val)
+		if err != nil || !u.IsAbs() || u.Host == "" {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
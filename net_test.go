@@ -0,0 +1,51 @@
+package valid
+
+import (
+	"testing"
+)
+
+var netTests = []stringTest{
+	// IsIPAddress
+	{"192.168.1.1", IsIPAddress("a"), true, "192.168.1.1 is a valid IP address."},
+	{"::1", IsIPAddress("a"), true, "::1 is a valid IP address."},
+	{"not an ip", IsIPAddress("a"), false, "\"not an ip\" is not a valid IP address."},
+
+	// IsIPv4Address
+	{"192.168.1.1", IsIPv4Address("a"), true, "192.168.1.1 is a valid IPv4 address."},
+	{"::1", IsIPv4Address("a"), false, "::1 is not a valid IPv4 address."},
+
+	// IsIPv6Address
+	{"::1", IsIPv6Address("a"), true, "::1 is a valid IPv6 address."},
+	{"192.168.1.1", IsIPv6Address("a"), false, "192.168.1.1 is not a valid IPv6 address."},
+
+	// IsCIDR
+	{"192.0.2.0/24", IsCIDR("a"), true, "192.0.2.0/24 is a valid CIDR."},
+	{"192.0.2.0", IsCIDR("a"), false, "192.0.2.0 is not a valid CIDR."},
+
+	// IsMACAddress
+	{"01:23:45:67:89:ab", IsMACAddress("a"), true, "01:23:45:67:89:ab is a valid MAC address."},
+	{"not a mac", IsMACAddress("a"), false, "\"not a mac\" is not a valid MAC address."},
+
+	// IsPortNumber
+	{"80", IsPortNumber("a"), true, "80 is a valid port number."},
+	{"65535", IsPortNumber("a"), true, "65535 is a valid port number."},
+	{"65536", IsPortNumber("a"), false, "65536 is not a valid port number."},
+	{"-1", IsPortNumber("a"), false, "-1 is not a valid port number."},
+	{"abc", IsPortNumber("a"), false, "\"abc\" is not a valid port number."},
+
+	// IsURL
+	{"https://example.com/path", IsURL("a"), true, "https://example.com/path is a valid URL."},
+	{"example.com", IsURL("a"), false, "example.com has no scheme, so it is not a valid absolute URL."},
+	{"://bad", IsURL("a"), false, "\"://bad\" is not a valid URL."},
+}
+
+func TestNetValidators(t *testing.T) {
+	var err error
+	for i, test := range netTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Net #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
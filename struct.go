@@ -0,0 +1,151 @@
+package valid
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// tagName is the struct tag read by Struct to determine which validators to run against each field.
+const tagName = "valid"
+
+// Struct validates the exported fields of v using the rules given in their `valid` struct tags
+// and returns the aggregated field errors.
+//
+// Each field's tag is a comma-separated list of rules, e.g. `valid:"nonempty,maxlen=20,email"`.
+// Rules that take a parameter use "=", and rules that take two parameters separate them with
+// a ":", e.g. `valid:"len=2:20"`. Supported rules are: nonempty, maxlen=N, minlen=N, len=N:M,
+// email, alphanumeric for string fields, and atleast=N, atmost=N, between=N:M for int fields.
+//
+// Fields without a `valid` tag, and unexported fields, are skipped. Struct panics if a rule's
+// parameter cannot be parsed, since that means the tag itself is malformed rather than the
+// value being validated.
+func Struct(v interface{}) Errors {
+	errs := Errors{}
+
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return errs
+	}
+
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag := field.Tag.Get(tagName)
+		if tag == "" || tag == "-" {
+			continue
+		}
+		errs = append(errs, structField(field.Name, val.Field(i), tag)...)
+	}
+
+	return errs
+}
+
+// structField validates a single struct field against its parsed `valid` tag rules.
+func structField(name string, fval reflect.Value, tag string) Errors {
+	errs := Errors{}
+
+	for _, rule := range strings.Split(tag, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		key, arg := rule, ""
+		if idx := strings.Index(rule, "="); idx != -1 {
+			key, arg = rule[:idx], rule[idx+1:]
+		}
+
+		err := structFieldRule(name, fval, key, arg, rule)
+		if err != nil {
+			errs = append(errs, FieldError{Field: name, Err: err})
+		}
+	}
+
+	return errs
+}
+
+// structFieldRule applies a single parsed rule (key, optional arg) to a field value. rule is
+// the original, unparsed rule text, used for panic messages.
+func structFieldRule(name string, fval reflect.Value, key, arg, rule string) error {
+	switch fval.Kind() {
+	case reflect.String:
+		return structStringRule(name, fval.String(), key, arg, rule)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return structIntRule(name, int(fval.Int()), key, arg, rule)
+	default:
+		return nil
+	}
+}
+
+func structStringRule(name, val, key, arg, rule string) error {
+	message := fmt.Sprintf("%s is invalid", name)
+
+	switch key {
+	case "nonempty":
+		return Nonempty(message).Validate(val)
+	case "email":
+		return Email(message).Validate(val)
+	case "alphanumeric":
+		return Alphanumeric(message).Validate(val)
+	case "maxlen":
+		return MaxLen(tagInt(name, rule, arg), message).Validate(val)
+	case "minlen":
+		return MinLen(tagInt(name, rule, arg), message).Validate(val)
+	case "len":
+		min, max := tagIntPair(name, rule, arg)
+		return Len(min, max, message).Validate(val)
+	}
+	return nil
+}
+
+func structIntRule(name string, val int, key, arg, rule string) error {
+	message := fmt.Sprintf("%s is invalid", name)
+
+	switch key {
+	case "atleast":
+		return IntAtLeast(tagInt(name, rule, arg), message).Validate(val)
+	case "atmost":
+		return IntAtMost(tagInt(name, rule, arg), message).Validate(val)
+	case "between":
+		min, max := tagIntPair(name, rule, arg)
+		return IntBetween(min, max, message).Validate(val)
+	}
+	return nil
+}
+
+// tagInt parses arg as an int, panicking with the offending field name and rule if arg is not
+// a valid integer. A malformed tag is a programming error, not a validation failure, so it
+// must fail loudly instead of silently disabling the rule.
+func tagInt(name, rule, arg string) int {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		panic(fmt.Sprintf("valid: invalid `valid` tag rule %q on field %q: %v", rule, name, err))
+	}
+	return n
+}
+
+// tagIntPair parses arg as a "min:max" pair, panicking with the offending field name and rule
+// if arg is malformed. See tagInt.
+func tagIntPair(name, rule, arg string) (min, max int) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) != 2 {
+		panic(fmt.Sprintf("valid: invalid `valid` tag rule %q on field %q: expected \"min:max\"", rule, name))
+	}
+	min, err := strconv.Atoi(parts[0])
+	if err != nil {
+		panic(fmt.Sprintf("valid: invalid `valid` tag rule %q on field %q: %v", rule, name, err))
+	}
+	max, err = strconv.Atoi(parts[1])
+	if err != nil {
+		panic(fmt.Sprintf("valid: invalid `valid` tag rule %q on field %q: %v", rule, name, err))
+	}
+	return min, max
+}
@@ -0,0 +1,65 @@
+package valid
+
+import (
+	"testing"
+)
+
+type signupForm struct {
+	Email string `valid:"nonempty,email"`
+	Name  string `valid:"maxlen=20"`
+	Age   int    `valid:"atleast=18"`
+	Notes string
+	skip  string `valid:"nonempty"`
+}
+
+func TestStructValid(t *testing.T) {
+	form := signupForm{Email: "john@example.com", Name: "John", Age: 21}
+	errs := Struct(form)
+	if len(errs) != 0 {
+		t.Errorf("expected no errors for a valid struct, got %v", errs)
+	}
+}
+
+func TestStructInvalid(t *testing.T) {
+	form := signupForm{Email: "not-an-email", Name: "a very long name that is too long", Age: 10}
+	errs := Struct(form)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %d: %v", len(errs), errs)
+	}
+
+	byField := errs.ByField()
+	for _, field := range []string{"Email", "Name", "Age"} {
+		if len(byField[field]) != 1 {
+			t.Errorf("expected 1 error for field %q, got %d", field, len(byField[field]))
+		}
+	}
+}
+
+func TestStructPointer(t *testing.T) {
+	form := &signupForm{Email: "john@example.com", Name: "John", Age: 21}
+	errs := Struct(form)
+	if len(errs) != 0 {
+		t.Errorf("expected no errors for a valid struct pointer, got %v", errs)
+	}
+}
+
+func TestStructSkipsUntaggedAndUnexported(t *testing.T) {
+	form := signupForm{Email: "john@example.com", Name: "John", Age: 21, Notes: "", skip: ""}
+	errs := Struct(form)
+	if len(errs) != 0 {
+		t.Errorf("Notes (untagged) and skip (unexported) should not be validated, got %v", errs)
+	}
+}
+
+func TestStructPanicsOnMalformedTag(t *testing.T) {
+	type badForm struct {
+		Name string `valid:"maxlen=2o"`
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Struct should panic on a malformed tag argument instead of silently skipping the rule")
+		}
+	}()
+	Struct(badForm{Name: "John"})
+}
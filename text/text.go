@@ -0,0 +1,55 @@
+/*
+Package text provides grapheme-cluster-aware length validators for the parent valid package.
+
+Counting bytes (len) or runes (valid.RuneLen) both overcount what a user perceives as a single
+character for text containing combining diacritics, since those are separate runes grouped onto
+a preceding base rune. GraphemeLen and the MinGraphemeLen/MaxGraphemeLen validators approximate
+grapheme cluster counting by normalising to NFC and counting the runes that remain, except
+nonspacing marks (Unicode category Mn), which covers the common case of a base letter followed
+by combining accents. Other multi-rune clusters, such as emoji sequences, count one per rune.
+*/
+package text
+
+import (
+	"errors"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/vladvelici/valid"
+)
+
+// GraphemeLen counts the approximate number of grapheme clusters in s.
+func GraphemeLen(s string) int {
+	n := 0
+	for _, r := range norm.NFC.String(s) {
+		if !unicode.Is(unicode.Mn, r) {
+			n++
+		}
+	}
+	return n
+}
+
+// MinGraphemeLen creates a minimum length validator that consideres all strings val valid if
+// they satisfy GraphemeLen(val) >= min.
+func MinGraphemeLen(min int, message string) valid.StringValidator {
+	f := func(val string) error {
+		if GraphemeLen(val) < min {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return valid.StringFunc(f)
+}
+
+// MaxGraphemeLen creates a maximum length validator that consideres all strings val valid if
+// they satisfy GraphemeLen(val) <= max.
+func MaxGraphemeLen(max int, message string) valid.StringValidator {
+	f := func(val string) error {
+		if GraphemeLen(val) > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return valid.StringFunc(f)
+}
@@ -0,0 +1,30 @@
+package text
+
+import (
+	"testing"
+)
+
+func TestGraphemeLen(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"hello", 5},
+		// "e" followed by a combining acute accent (U+0065 U+0301) normalises to a single rune.
+		{"é", 1},
+	}
+	for i, test := range tests {
+		if got := GraphemeLen(test.input); got != test.want {
+			t.Errorf("GraphemeLen #%d: got %d, want %d", i, got, test.want)
+		}
+	}
+}
+
+func TestMinMaxGraphemeLen(t *testing.T) {
+	if err := MinGraphemeLen(5, "too short").Validate("ébcde"); err != nil {
+		t.Errorf("expected 5 graphemes to satisfy MinGraphemeLen(5), got error: %v", err)
+	}
+	if err := MaxGraphemeLen(4, "too long").Validate("ébcde"); err == nil {
+		t.Errorf("expected 5 graphemes to fail MaxGraphemeLen(4)")
+	}
+}
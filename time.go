@@ -0,0 +1,74 @@
+package valid
+
+import (
+	"errors"
+	"time"
+)
+
+// TimeValidator interface is implemented by all time.Time validators.
+type TimeValidator interface {
+	// Validate validates the given parameter and returns a validation error, or nil
+	// if the input is valid.
+	Validate(time.Time) error
+}
+
+// A TimeFunc takes a value to validate and returns a validation error.
+//
+// This type implements the TimeValidator interface, thus any functions with this
+// signature can be casted to TimeFunc and used as a TimeValidator.
+type TimeFunc func(val time.Time) error
+
+// Validate function of TimeFunc
+func (f TimeFunc) Validate(val time.Time) error {
+	return f(val)
+}
+
+// Time applies a list of TimeValidators to a time.Time value and returns a list of
+// aggregated errors.
+func Time(val time.Time, v ...TimeValidator) []error {
+	errors := make([]error, 0)
+	for _, validator := range v {
+		err := validator.Validate(val)
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
+
+// TimeBefore creates a validator that consideres a time.Time value valid if it is strictly
+// before the given reference time.
+func TimeBefore(ref time.Time, message string) TimeValidator {
+	f := func(val time.Time) error {
+		if !val.Before(ref) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return TimeFunc(f)
+}
+
+// TimeAfter creates a validator that consideres a time.Time value valid if it is strictly
+// after the given reference time.
+func TimeAfter(ref time.Time, message string) TimeValidator {
+	f := func(val time.Time) error {
+		if !val.After(ref) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return TimeFunc(f)
+}
+
+// TimeBetween creates a validator that checks if the given time.Time is in the closed interval
+// [min, max]. It includes min and max: all val that satisfy !val.Before(min) && !val.After(max)
+// are considered valid.
+func TimeBetween(min, max time.Time, message string) TimeValidator {
+	f := func(val time.Time) error {
+		if val.Before(min) || val.After(max) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return TimeFunc(f)
+}
@@ -0,0 +1,60 @@
+package valid
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	timeNow   = time.Date(2020, time.June, 15, 0, 0, 0, 0, time.UTC)
+	timePast  = timeNow.Add(-24 * time.Hour)
+	timeFutur = timeNow.Add(24 * time.Hour)
+)
+
+type timeTest struct {
+	input      time.Time
+	validator  TimeValidator
+	shouldPass bool
+	message    string
+}
+
+var timeTests = []timeTest{
+	// TimeBefore
+	{timePast, TimeBefore(timeNow, "a"), true, "timePast is before timeNow."},
+	{timeNow, TimeBefore(timeNow, "a"), false, "timeNow is not before itself."},
+	{timeFutur, TimeBefore(timeNow, "a"), false, "timeFutur is not before timeNow."},
+
+	// TimeAfter
+	{timeFutur, TimeAfter(timeNow, "a"), true, "timeFutur is after timeNow."},
+	{timeNow, TimeAfter(timeNow, "a"), false, "timeNow is not after itself."},
+	{timePast, TimeAfter(timeNow, "a"), false, "timePast is not after timeNow."},
+
+	// TimeBetween
+	{timeNow, TimeBetween(timePast, timeFutur, "a"), true, "timeNow is between timePast and timeFutur."},
+	{timePast, TimeBetween(timePast, timeFutur, "a"), true, "timePast is in range [timePast, timeFutur]."},
+	{timeFutur, TimeBetween(timePast, timeFutur, "a"), true, "timeFutur is in range [timePast, timeFutur]."},
+	{timePast.Add(-time.Second), TimeBetween(timePast, timeFutur, "a"), false, "before timePast is not in range."},
+	{timeFutur.Add(time.Second), TimeBetween(timePast, timeFutur, "a"), false, "after timeFutur is not in range."},
+}
+
+func TestTimeValidators(t *testing.T) {
+	var err error
+	for i, test := range timeTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Time #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	errs := Time(timeNow, TimeAfter(timePast, "this should pass"), TimeBefore(timePast, "this should fail"))
+	if len(errs) != 1 {
+		t.Errorf("Time function returns the wrong number of errors: %d instead of 1.", len(errs))
+		t.FailNow()
+	}
+	if errs[0].Error() != "this should fail" {
+		t.Errorf("Time() returns the wrong message: errs[0] = %#v instead of %#v", errs[0].Error(), "this should fail")
+	}
+}
@@ -0,0 +1,44 @@
+package valid
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// RuneLen creates a length validator, like Len, but counting runes via utf8.RuneCountInString
+// instead of bytes, so multi-byte characters count as one. It checks that the rune count of
+// val is in the closed interval [min, max].
+func RuneLen(min, max int, message string) StringValidator {
+	f := func(val string) error {
+		n := utf8.RuneCountInString(val)
+		if n < min || n > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// MinRuneLen creates a minimum length validator, like MinLen, but counting runes via
+// utf8.RuneCountInString instead of bytes, so multi-byte characters count as one.
+func MinRuneLen(min int, message string) StringValidator {
+	f := func(val string) error {
+		if utf8.RuneCountInString(val) < min {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
+
+// MaxRuneLen creates a maximum length validator, like MaxLen, but counting runes via
+// utf8.RuneCountInString instead of bytes, so multi-byte characters count as one.
+func MaxRuneLen(max int, message string) StringValidator {
+	f := func(val string) error {
+		if utf8.RuneCountInString(val) > max {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return StringFunc(f)
+}
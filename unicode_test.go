@@ -0,0 +1,30 @@
+package valid
+
+import (
+	"testing"
+)
+
+var unicodeTests = []stringTest{
+	// RuneLen
+	{"héllo", RuneLen(5, 5, "a"), true, "héllo has 5 runes, even though é is 2 bytes."},
+	{"héllo", RuneLen(1, 4, "a"), false, "héllo has more than 4 runes."},
+
+	// MinRuneLen
+	{"héllo", MinRuneLen(5, "a"), true, "héllo has at least 5 runes."},
+	{"héllo", MinRuneLen(6, "a"), false, "héllo has fewer than 6 runes."},
+
+	// MaxRuneLen
+	{"héllo", MaxRuneLen(5, "a"), true, "héllo has at most 5 runes."},
+	{"héllo", MaxRuneLen(4, "a"), false, "héllo has more than 4 runes."},
+}
+
+func TestUnicodeValidators(t *testing.T) {
+	var err error
+	for i, test := range unicodeTests {
+		err = test.validator.Validate(test.input)
+		pass := err == nil
+		if pass != test.shouldPass {
+			t.Errorf("Unicode #%d failed [%v/%v]: %s", i, pass, test.shouldPass, test.message)
+		}
+	}
+}